@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// Created holds who and when something has been created. It is meant to be
+// embedded in domain events needing to track the actor behind a state change.
+type Created struct {
+	by UserID
+	at time.Time
+}
+
+// NewCreated builds a new Created value.
+func NewCreated(by UserID, at time.Time) Created {
+	return Created{by: by, at: at}
+}
+
+// By returns the id of the user behind this change.
+func (c Created) By() UserID {
+	return c.by
+}
+
+// At returns when this change occurred.
+func (c Created) At() time.Time {
+	return c.at
+}
@@ -0,0 +1,21 @@
+package domain
+
+import "context"
+
+// UserID uniquely identifies a user in the system.
+type UserID string
+
+type userIDContextKey struct{}
+
+// WithUserID returns a new context carrying the given user id, used by command
+// handlers to know who initiated the request being processed.
+func WithUserID(ctx context.Context, uid UserID) context.Context {
+	return context.WithValue(ctx, userIDContextKey{}, uid)
+}
+
+// CurrentUser retrieves the user id previously stored by WithUserID.
+func CurrentUser(ctx context.Context) (UserID, bool) {
+	uid, ok := ctx.Value(userIDContextKey{}).(UserID)
+
+	return uid, ok
+}
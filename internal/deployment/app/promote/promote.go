@@ -0,0 +1,166 @@
+// Package promote exposes the use case letting a user promote an existing
+// deployment, creating a new one based on it.
+package promote
+
+import (
+	"context"
+	"errors"
+
+	auth "github.com/YuukanOO/seelf/internal/auth/domain"
+	"github.com/YuukanOO/seelf/internal/deployment/domain"
+	"github.com/YuukanOO/seelf/pkg/apperr"
+	"github.com/YuukanOO/seelf/pkg/bus"
+)
+
+type (
+	// Command holds everything needed to promote an existing deployment.
+	Command struct {
+		AppID            string
+		DeploymentNumber int
+		// DryRun, when set, makes the handler stop right before persisting the
+		// new deployment, only returning the number it would have been assigned.
+		DryRun bool
+		// RequestID is an optional, client supplied idempotency key. Retrying
+		// a command with the same (AppID, RequestID) pair returns the number
+		// of the deployment already created for it instead of creating a new one.
+		RequestID string
+	}
+
+	// Plan describes what a promotion would do, without actually doing it.
+	Plan struct {
+		AppID            domain.AppID
+		SourceDeployment domain.DeploymentID
+		Target           domain.TargetID
+		Number           int
+		Env              domain.Environment
+		Payload          domain.Source
+		// AvailabilityErr holds the result of checking the target availability,
+		// nil if the corresponding Command would be free to proceed.
+		AvailabilityErr error
+	}
+
+	// Preview holds everything needed to compute the Plan for a would-be promotion.
+	Preview struct {
+		AppID            string
+		DeploymentNumber int
+	}
+)
+
+func (Command) Request() int  { return 0 }
+func (Preview) Request() Plan { return Plan{} }
+
+// Handler builds the promote.Command handler.
+func Handler(
+	apps domain.AppsReader,
+	targets domain.TargetsReader,
+	deploymentsReader domain.DeploymentsReader,
+	deploymentsWriter domain.DeploymentsWriter,
+) bus.RequestHandler[int, Command] {
+	return func(ctx context.Context, cmd Command) (int, error) {
+		uid, _ := auth.CurrentUser(ctx)
+
+		app, err := apps.GetByID(ctx, domain.AppID(cmd.AppID))
+
+		if err != nil {
+			return 0, err
+		}
+
+		if cmd.RequestID != "" {
+			existing, err := deploymentsReader.GetByRequestID(ctx, app.ID(), cmd.RequestID)
+
+			if err == nil {
+				return existing.ID().DeploymentNumber(), nil
+			} else if !errors.Is(err, apperr.ErrNotFound) {
+				return 0, err
+			}
+		}
+
+		source, err := deploymentsReader.GetByID(ctx, domain.NewDeploymentID(app.ID(), cmd.DeploymentNumber))
+
+		if err != nil {
+			return 0, err
+		}
+
+		target, err := targets.GetByID(ctx, app.Config(source.Environment()).Target())
+
+		if err != nil {
+			return 0, err
+		}
+
+		if err := target.CheckAvailability(); err != nil {
+			return 0, err
+		}
+
+		number, err := deploymentsReader.GetNextDeploymentNumber(ctx, app.ID())
+
+		if err != nil {
+			return 0, err
+		}
+
+		if cmd.DryRun {
+			return number, nil
+		}
+
+		deployment, err := app.NewDeployment(number, source.Source(), source.Environment(), uid, domain.WithRequestID(cmd.RequestID))
+
+		if err != nil {
+			return 0, err
+		}
+
+		if err := deploymentsWriter.Write(ctx, &deployment); err != nil {
+			return 0, err
+		}
+
+		return number, nil
+	}
+}
+
+// PreviewHandler builds the promote.Preview handler, computing the Plan a
+// promotion would result in without mutating anything. The target
+// availability is checked but, unlike Handler, never fails the preview: it is
+// instead surfaced through Plan.AvailabilityErr so callers can tell whether
+// the corresponding Command would actually go through.
+func PreviewHandler(
+	apps domain.AppsReader,
+	targets domain.TargetsReader,
+	deploymentsReader domain.DeploymentsReader,
+) bus.RequestHandler[Plan, Preview] {
+	return func(ctx context.Context, cmd Preview) (Plan, error) {
+		app, err := apps.GetByID(ctx, domain.AppID(cmd.AppID))
+
+		if err != nil {
+			return Plan{}, err
+		}
+
+		source, err := deploymentsReader.GetByID(ctx, domain.NewDeploymentID(app.ID(), cmd.DeploymentNumber))
+
+		if err != nil {
+			return Plan{}, err
+		}
+
+		env := source.Environment()
+		targetID := app.Config(env).Target()
+
+		target, err := targets.GetByID(ctx, targetID)
+
+		if err != nil {
+			return Plan{}, err
+		}
+
+		number, err := deploymentsReader.GetNextDeploymentNumber(ctx, app.ID())
+
+		if err != nil {
+			return Plan{}, err
+		}
+
+		return Plan{
+			AppID:            app.ID(),
+			SourceDeployment: source.ID(),
+			Target:           targetID,
+			Number:           number,
+			Env:              env,
+			Payload:          source.Source(),
+			AvailabilityErr:  target.CheckAvailability(),
+		}, nil
+	}
+}
@@ -2,6 +2,7 @@ package promote_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	auth "github.com/YuukanOO/seelf/internal/auth/domain"
@@ -17,14 +18,26 @@ import (
 
 func Test_Promote(t *testing.T) {
 	ctx := auth.WithUserID(context.Background(), "some-uid")
+
+	newReadyTarget := func() domain.Target {
+		target := must.Panic(domain.NewTarget("my-target",
+			domain.NewTargetUrlRequirement(must.Panic(domain.UrlFrom("http://target.local")), true),
+			domain.NewProviderConfigRequirement(dummyProviderConfig{}, true), "some-uid"))
+		target.Configured(target.CurrentVersion(), nil)
+		return target
+	}
+
+	target := newReadyTarget()
+	targetsStore := memory.NewTargetsStore(&target)
+
 	app := must.Panic(domain.NewApp("my-app",
-		domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig("1"), true, true),
-		domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig("1"), true, true), "some-uid"))
+		domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig(target.ID()), true, true),
+		domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig(target.ID()), true, true), "some-uid"))
 	appsStore := memory.NewAppsStore(&app)
 
 	sut := func(existingDeployments ...*domain.Deployment) bus.RequestHandler[int, promote.Command] {
 		deploymentsStore := memory.NewDeploymentsStore(existingDeployments...)
-		return promote.Handler(appsStore, deploymentsStore, deploymentsStore)
+		return promote.Handler(appsStore, targetsStore, deploymentsStore, deploymentsStore)
 	}
 
 	t.Run("should fail if application does not exist", func(t *testing.T) {
@@ -60,4 +73,200 @@ func Test_Promote(t *testing.T) {
 		testutil.IsNil(t, err)
 		testutil.Equals(t, 2, number)
 	})
+
+	sutPreview := func(existingDeployments ...*domain.Deployment) bus.RequestHandler[promote.Plan, promote.Preview] {
+		deploymentsStore := memory.NewDeploymentsStore(existingDeployments...)
+		return promote.PreviewHandler(appsStore, targetsStore, deploymentsStore)
+	}
+
+	t.Run("preview should fail if application does not exist", func(t *testing.T) {
+		uc := sutPreview()
+		plan, err := uc(ctx, promote.Preview{
+			AppID: "some-app-id",
+		})
+
+		testutil.ErrorIs(t, apperr.ErrNotFound, err)
+		testutil.Equals(t, 0, plan.Number)
+	})
+
+	t.Run("preview should returns the next number and payload without mutating the store", func(t *testing.T) {
+		dpl, _ := app.NewDeployment(1, raw.Data("some-payload"), domain.Staging, "some-uid")
+		deploymentsStore := memory.NewDeploymentsStore(&dpl)
+		previewUc := promote.PreviewHandler(appsStore, targetsStore, deploymentsStore)
+		promoteUc := promote.Handler(appsStore, targetsStore, deploymentsStore, deploymentsStore)
+
+		plan, err := previewUc(ctx, promote.Preview{
+			AppID:            string(dpl.ID().AppID()),
+			DeploymentNumber: int(dpl.ID().DeploymentNumber()),
+		})
+
+		testutil.IsNil(t, err)
+		testutil.Equals(t, 2, plan.Number)
+		testutil.Equals(t, domain.Source(raw.Data("some-payload")), plan.Payload)
+		testutil.IsNil(t, plan.AvailabilityErr)
+
+		// Previewing must not have persisted anything.
+		nextNumber, err := deploymentsStore.GetNextDeploymentNumber(ctx, dpl.ID().AppID())
+		testutil.IsNil(t, err)
+		testutil.Equals(t, 2, nextNumber)
+
+		// A subsequent promotion should produce the same number the preview predicted.
+		number, err := promoteUc(ctx, promote.Command{
+			AppID:            string(dpl.ID().AppID()),
+			DeploymentNumber: int(dpl.ID().DeploymentNumber()),
+		})
+
+		testutil.IsNil(t, err)
+		testutil.Equals(t, plan.Number, number)
+	})
+
+	t.Run("should fail if the target is still configuring", func(t *testing.T) {
+		configuringTarget := must.Panic(domain.NewTarget("configuring-target",
+			domain.NewTargetUrlRequirement(must.Panic(domain.UrlFrom("http://configuring.local")), true),
+			domain.NewProviderConfigRequirement(dummyProviderConfig{}, true), "some-uid"))
+		configuringApp := must.Panic(domain.NewApp("configuring-app",
+			domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig(configuringTarget.ID()), true, true),
+			domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig(configuringTarget.ID()), true, true), "some-uid"))
+		dpl, _ := configuringApp.NewDeployment(1, raw.Data(""), domain.Staging, "some-uid")
+
+		uc := promote.Handler(
+			memory.NewAppsStore(&configuringApp),
+			memory.NewTargetsStore(&configuringTarget),
+			memory.NewDeploymentsStore(&dpl),
+			memory.NewDeploymentsStore(&dpl),
+		)
+
+		_, err := uc(ctx, promote.Command{
+			AppID:            string(dpl.ID().AppID()),
+			DeploymentNumber: int(dpl.ID().DeploymentNumber()),
+		})
+
+		testutil.ErrorIs(t, domain.ErrTargetConfigurationInProgress, err)
+	})
+
+	t.Run("preview should surface the target availability error without failing", func(t *testing.T) {
+		configuringTarget := must.Panic(domain.NewTarget("configuring-target-preview",
+			domain.NewTargetUrlRequirement(must.Panic(domain.UrlFrom("http://configuring-preview.local")), true),
+			domain.NewProviderConfigRequirement(dummyProviderConfig{}, true), "some-uid"))
+		configuringApp := must.Panic(domain.NewApp("configuring-app-preview",
+			domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig(configuringTarget.ID()), true, true),
+			domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig(configuringTarget.ID()), true, true), "some-uid"))
+		dpl, _ := configuringApp.NewDeployment(1, raw.Data(""), domain.Staging, "some-uid")
+
+		previewUc := promote.PreviewHandler(
+			memory.NewAppsStore(&configuringApp),
+			memory.NewTargetsStore(&configuringTarget),
+			memory.NewDeploymentsStore(&dpl),
+		)
+
+		plan, err := previewUc(ctx, promote.Preview{
+			AppID:            string(dpl.ID().AppID()),
+			DeploymentNumber: int(dpl.ID().DeploymentNumber()),
+		})
+
+		testutil.IsNil(t, err)
+		testutil.Equals(t, 2, plan.Number)
+		testutil.ErrorIs(t, domain.ErrTargetConfigurationInProgress, plan.AvailabilityErr)
+	})
+
+	t.Run("should fail if the target configuration failed", func(t *testing.T) {
+		failedTarget := must.Panic(domain.NewTarget("failed-target",
+			domain.NewTargetUrlRequirement(must.Panic(domain.UrlFrom("http://failed.local")), true),
+			domain.NewProviderConfigRequirement(dummyProviderConfig{}, true), "some-uid"))
+		failedTarget.Configured(failedTarget.CurrentVersion(), errors.New("boom"))
+
+		failedApp := must.Panic(domain.NewApp("failed-app",
+			domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig(failedTarget.ID()), true, true),
+			domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig(failedTarget.ID()), true, true), "some-uid"))
+		dpl, _ := failedApp.NewDeployment(1, raw.Data(""), domain.Staging, "some-uid")
+
+		deploymentsStore := memory.NewDeploymentsStore(&dpl)
+		uc := promote.Handler(memory.NewAppsStore(&failedApp), memory.NewTargetsStore(&failedTarget), deploymentsStore, deploymentsStore)
+
+		_, err := uc(ctx, promote.Command{
+			AppID:            string(dpl.ID().AppID()),
+			DeploymentNumber: int(dpl.ID().DeploymentNumber()),
+		})
+
+		testutil.ErrorIs(t, domain.ErrTargetConfigurationFailed, err)
+	})
+
+	t.Run("should fail if the target cleanup has been requested", func(t *testing.T) {
+		cleaningTarget := newReadyTarget()
+		testutil.IsNil(t, cleaningTarget.RequestCleanup(domain.CleanupOptions{}, "some-uid"))
+
+		cleaningApp := must.Panic(domain.NewApp("cleaning-app",
+			domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig(cleaningTarget.ID()), true, true),
+			domain.NewEnvironmentConfigRequirement(domain.NewEnvironmentConfig(cleaningTarget.ID()), true, true), "some-uid"))
+		dpl, _ := cleaningApp.NewDeployment(1, raw.Data(""), domain.Staging, "some-uid")
+
+		deploymentsStore := memory.NewDeploymentsStore(&dpl)
+		uc := promote.Handler(memory.NewAppsStore(&cleaningApp), memory.NewTargetsStore(&cleaningTarget), deploymentsStore, deploymentsStore)
+
+		_, err := uc(ctx, promote.Command{
+			AppID:            string(dpl.ID().AppID()),
+			DeploymentNumber: int(dpl.ID().DeploymentNumber()),
+		})
+
+		testutil.ErrorIs(t, domain.ErrTargetCleanupRequested, err)
+	})
+
+	t.Run("should return the same number when retried with the same RequestID", func(t *testing.T) {
+		dpl, _ := app.NewDeployment(1, raw.Data(""), domain.Staging, "some-uid")
+		deploymentsStore := memory.NewDeploymentsStore(&dpl)
+		uc := promote.Handler(appsStore, targetsStore, deploymentsStore, deploymentsStore)
+
+		cmd := promote.Command{
+			AppID:            string(dpl.ID().AppID()),
+			DeploymentNumber: int(dpl.ID().DeploymentNumber()),
+			RequestID:        "some-request-id",
+		}
+
+		first, err := uc(ctx, cmd)
+		testutil.IsNil(t, err)
+
+		second, err := uc(ctx, cmd)
+		testutil.IsNil(t, err)
+
+		testutil.Equals(t, first, second)
+
+		created, err := deploymentsStore.GetByID(ctx, domain.NewDeploymentID(dpl.ID().AppID(), first))
+		testutil.IsNil(t, err)
+		testutil.HasNEvents(t, &created, 1)
+
+		_, err = deploymentsStore.GetByID(ctx, domain.NewDeploymentID(dpl.ID().AppID(), first+1))
+		testutil.ErrorIs(t, apperr.ErrNotFound, err)
+	})
+
+	t.Run("should create distinct deployments for distinct RequestIDs", func(t *testing.T) {
+		dpl, _ := app.NewDeployment(1, raw.Data(""), domain.Staging, "some-uid")
+		deploymentsStore := memory.NewDeploymentsStore(&dpl)
+		uc := promote.Handler(appsStore, targetsStore, deploymentsStore, deploymentsStore)
+
+		first, err := uc(ctx, promote.Command{
+			AppID:            string(dpl.ID().AppID()),
+			DeploymentNumber: int(dpl.ID().DeploymentNumber()),
+			RequestID:        "first-request-id",
+		})
+		testutil.IsNil(t, err)
+
+		second, err := uc(ctx, promote.Command{
+			AppID:            string(dpl.ID().AppID()),
+			DeploymentNumber: int(dpl.ID().DeploymentNumber()),
+			RequestID:        "second-request-id",
+		})
+		testutil.IsNil(t, err)
+
+		testutil.NotEquals(t, first, second)
+	})
+}
+
+type dummyProviderConfig struct{}
+
+func (dummyProviderConfig) Kind() string        { return "dummy" }
+func (dummyProviderConfig) Fingerprint() string { return "" }
+func (dummyProviderConfig) String() string      { return "" }
+
+func (d dummyProviderConfig) Equals(other domain.ProviderConfig) bool {
+	return d == other
 }
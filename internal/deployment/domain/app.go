@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"time"
+
+	auth "github.com/YuukanOO/seelf/internal/auth/domain"
+	"github.com/YuukanOO/seelf/pkg/event"
+	"github.com/YuukanOO/seelf/pkg/id"
+)
+
+type (
+	// AppID uniquely identifies an App.
+	AppID string
+
+	// EnvironmentConfig holds everything an environment (staging or
+	// production) needs to know to deploy an app, starting with the target
+	// it should be deployed to.
+	EnvironmentConfig struct {
+		target TargetID
+	}
+
+	// EnvironmentConfigRequirement carries the result of the checks a caller
+	// already ran against the targets store for the given config.
+	EnvironmentConfigRequirement struct {
+		config      EnvironmentConfig
+		targetFound bool
+		targetReady bool
+	}
+
+	// App represents a deployable application, configured to deploy on a
+	// target for each of its environments.
+	App struct {
+		event.Emitter
+
+		id         AppID
+		name       string
+		staging    EnvironmentConfig
+		production EnvironmentConfig
+		created    auth.Created
+	}
+
+	AppCreated struct {
+		ID      AppID
+		Name    string
+		Created auth.Created
+	}
+)
+
+func (AppCreated) IsEvent() {}
+
+// NewEnvironmentConfig builds a new environment configuration deploying on the given target.
+func NewEnvironmentConfig(target TargetID) EnvironmentConfig {
+	return EnvironmentConfig{target: target}
+}
+
+// Target returns the target this environment deploys to.
+func (c EnvironmentConfig) Target() TargetID {
+	return c.target
+}
+
+// NewEnvironmentConfigRequirement builds a new requirement for the given
+// config, targetFound and targetReady being the result of the checks already
+// performed by the caller against the targets store.
+func NewEnvironmentConfigRequirement(config EnvironmentConfig, targetFound, targetReady bool) EnvironmentConfigRequirement {
+	return EnvironmentConfigRequirement{config: config, targetFound: targetFound, targetReady: targetReady}
+}
+
+// NewApp creates a new App, making sure both its staging and production
+// targets exist and are ready to be deployed to.
+func NewApp(name string, staging, production EnvironmentConfigRequirement, createdBy auth.UserID) (App, error) {
+	if !staging.targetFound || !production.targetFound {
+		return App{}, ErrTargetNotFound
+	}
+
+	if !staging.targetReady || !production.targetReady {
+		return App{}, ErrTargetConfigurationInProgress
+	}
+
+	var app App
+
+	app.id = AppID(id.New())
+	app.name = name
+	app.staging = staging.config
+	app.production = production.config
+	app.created = auth.NewCreated(createdBy, time.Now())
+
+	app.Raise(AppCreated{ID: app.id, Name: name, Created: app.created})
+
+	return app, nil
+}
+
+// ID returns this app unique identifier.
+func (a *App) ID() AppID {
+	return a.id
+}
+
+// Config returns the environment configuration for the given environment.
+func (a *App) Config(env Environment) EnvironmentConfig {
+	if env == Production {
+		return a.production
+	}
+
+	return a.staging
+}
+
+// UsesTarget returns true if this app is configured to deploy on the given target.
+func (a *App) UsesTarget(target TargetID) bool {
+	return a.staging.target == target || a.production.target == target
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"time"
+
+	auth "github.com/YuukanOO/seelf/internal/auth/domain"
+	"github.com/YuukanOO/seelf/pkg/event"
+)
+
+type (
+	// DeploymentID uniquely identifies a Deployment, being a simple sequence
+	// number scoped to a given App.
+	DeploymentID struct {
+		appID  AppID
+		number int
+	}
+
+	// Deployment represents a single attempt to deploy an app's source to one
+	// of its environments.
+	Deployment struct {
+		event.Emitter
+
+		id        DeploymentID
+		config    EnvironmentConfig
+		source    Source
+		env       Environment
+		requestID string
+		created   auth.Created
+	}
+
+	DeploymentCreated struct {
+		ID        DeploymentID
+		Source    Source
+		Env       Environment
+		RequestID string
+		Created   auth.Created
+	}
+
+	// DeploymentOption customizes a Deployment being created by NewDeployment.
+	DeploymentOption func(*Deployment)
+)
+
+func (DeploymentCreated) IsEvent() {}
+
+// WithRequestID tags the deployment being created with the given client
+// supplied idempotency key, letting retries (flaky CLI, webhook redelivery,
+// queue worker re-run, ...) be detected instead of creating a duplicate.
+func WithRequestID(requestID string) DeploymentOption {
+	return func(d *Deployment) {
+		d.requestID = requestID
+	}
+}
+
+// NewDeploymentID builds a DeploymentID for the given app and number, mostly
+// useful to look up a specific deployment from a store.
+func NewDeploymentID(appID AppID, number int) DeploymentID {
+	return DeploymentID{appID: appID, number: number}
+}
+
+// AppID returns the id of the app this deployment belongs to.
+func (id DeploymentID) AppID() AppID {
+	return id.appID
+}
+
+// DeploymentNumber returns the sequence number of this deployment.
+func (id DeploymentID) DeploymentNumber() int {
+	return id.number
+}
+
+// NewDeployment creates a new Deployment for the given app, at the given
+// number, deploying source to env.
+func (a *App) NewDeployment(number int, source Source, env Environment, by auth.UserID, opts ...DeploymentOption) (Deployment, error) {
+	var dpl Deployment
+
+	dpl.id = NewDeploymentID(a.id, number)
+	dpl.config = a.Config(env)
+	dpl.source = source
+	dpl.env = env
+	dpl.created = auth.NewCreated(by, time.Now())
+
+	for _, opt := range opts {
+		opt(&dpl)
+	}
+
+	dpl.Raise(DeploymentCreated{
+		ID:        dpl.id,
+		Source:    source,
+		Env:       env,
+		RequestID: dpl.requestID,
+		Created:   dpl.created,
+	})
+
+	return dpl, nil
+}
+
+// ID returns this deployment unique identifier.
+func (d *Deployment) ID() DeploymentID {
+	return d.id
+}
+
+// Source returns the source this deployment should deploy.
+func (d *Deployment) Source() Source {
+	return d.source
+}
+
+// Environment returns the environment this deployment targets.
+func (d *Deployment) Environment() Environment {
+	return d.env
+}
+
+// Config returns the environment configuration this deployment was created against.
+func (d *Deployment) Config() EnvironmentConfig {
+	return d.config
+}
+
+// RequestID returns the client supplied idempotency key this deployment was
+// created from, if any.
+func (d *Deployment) RequestID() string {
+	return d.requestID
+}
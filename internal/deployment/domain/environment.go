@@ -0,0 +1,9 @@
+package domain
+
+// Environment represents one of the two environments an app can be deployed to.
+type Environment uint8
+
+const (
+	Staging Environment = iota
+	Production
+)
@@ -0,0 +1,17 @@
+package domain
+
+import "errors"
+
+var (
+	// Target errors.
+	ErrUrlAlreadyTaken                  = errors.New("url_already_taken")
+	ErrConfigAlreadyTaken               = errors.New("config_already_taken")
+	ErrTargetProviderUpdateNotPermitted = errors.New("target_provider_update_not_permitted")
+	ErrTargetConfigurationInProgress    = errors.New("target_configuration_in_progress")
+	ErrTargetConfigurationFailed        = errors.New("target_configuration_failed")
+	ErrTargetCleanupRequested           = errors.New("target_cleanup_requested")
+	ErrTargetCleanupNeeded              = errors.New("target_cleanup_needed")
+	ErrTargetInUse                      = errors.New("target_in_use")
+	ErrRunningOrPendingDeployments      = errors.New("running_or_pending_deployments")
+	ErrTargetNotFound                   = errors.New("target_not_found")
+)
@@ -0,0 +1,28 @@
+package domain
+
+// ProviderConfig is implemented by every provider specific configuration
+// (docker, kubernetes, ...) a Target can be configured with.
+type ProviderConfig interface {
+	// Kind returns a unique identifier for this kind of provider.
+	Kind() string
+	// Fingerprint uniquely identifies the underlying infrastructure this
+	// configuration points to, regardless of its other settings. It is used
+	// to forbid switching a target to a totally different infrastructure.
+	Fingerprint() string
+	// Equals returns true if the other config represents the same configuration.
+	Equals(other ProviderConfig) bool
+	String() string
+}
+
+// ProviderConfigRequirement carries the result of the uniqueness check a
+// caller already ran against the targets store for the given config.
+type ProviderConfigRequirement struct {
+	config ProviderConfig
+	unique bool
+}
+
+// NewProviderConfigRequirement builds a new requirement for the given config,
+// isUnique being the result of the uniqueness check already performed by the caller.
+func NewProviderConfigRequirement(config ProviderConfig, isUnique bool) ProviderConfigRequirement {
+	return ProviderConfigRequirement{config: config, unique: isUnique}
+}
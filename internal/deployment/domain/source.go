@@ -0,0 +1,8 @@
+package domain
+
+// Source represents where the code to be deployed comes from (raw archive,
+// git repository, ...).
+type Source interface {
+	// Kind returns a unique identifier for this kind of source.
+	Kind() string
+}
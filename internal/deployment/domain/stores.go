@@ -0,0 +1,33 @@
+package domain
+
+import "context"
+
+type (
+	// AppsReader gives read access to the apps storage.
+	AppsReader interface {
+		GetByID(ctx context.Context, id AppID) (App, error)
+		// AppsUsingTarget returns the ids of every app configured to deploy on the given target.
+		AppsUsingTarget(ctx context.Context, target TargetID) ([]AppID, error)
+	}
+
+	// DeploymentsReader gives read access to the deployments storage.
+	DeploymentsReader interface {
+		GetByID(ctx context.Context, id DeploymentID) (Deployment, error)
+		// GetNextDeploymentNumber returns the number the next deployment for
+		// the given app should use.
+		GetNextDeploymentNumber(ctx context.Context, appID AppID) (int, error)
+		// GetByRequestID retrieves the deployment previously created for the
+		// given app with the given idempotency key, used to detect retries.
+		GetByRequestID(ctx context.Context, appID AppID, requestID string) (Deployment, error)
+	}
+
+	// DeploymentsWriter gives write access to the deployments storage.
+	DeploymentsWriter interface {
+		Write(ctx context.Context, deployment *Deployment) error
+	}
+
+	// TargetsReader gives read access to the targets storage.
+	TargetsReader interface {
+		GetByID(ctx context.Context, id TargetID) (Target, error)
+	}
+)
@@ -0,0 +1,433 @@
+package domain
+
+import (
+	"time"
+
+	auth "github.com/YuukanOO/seelf/internal/auth/domain"
+	"github.com/YuukanOO/seelf/pkg/event"
+	"github.com/YuukanOO/seelf/pkg/id"
+)
+
+type (
+	// TargetID uniquely identifies a Target.
+	TargetID string
+
+	// TargetStatus represents the lifecycle of a Target configuration.
+	TargetStatus uint8
+
+	// TargetState holds everything needed to know if a Target is ready to
+	// receive new deployments.
+	TargetState struct {
+		status   TargetStatus
+		version  time.Time
+		errCause error
+	}
+
+	// CleanupStrategy tells the caller how it should proceed when trying to
+	// remove resources (either a whole target or a single app) from a Target.
+	CleanupStrategy uint8
+
+	// CleanupOptions carries everything the Target aggregate needs to decide
+	// how a cleanup request should be handled, letting the caller resolve
+	// storage concerns (which apps use this target, do they have running or
+	// pending deployments) before calling into the domain.
+	CleanupOptions struct {
+		// AppsUsingTarget lists the apps currently configured to deploy on this target.
+		AppsUsingTarget []AppID
+		// HasPendingDeployments is true if at least one of the AppsUsingTarget
+		// has a running or pending deployment, in which case the cascading
+		// cleanup will be deferred until they drain.
+		HasPendingDeployments bool
+		// CleanupDependents opts in to the cascading cleanup of AppsUsingTarget
+		// instead of failing with ErrTargetInUse.
+		CleanupDependents bool
+	}
+
+	// Target represents a place where an application can be deployed to.
+	Target struct {
+		event.Emitter
+
+		id               TargetID
+		name             string
+		url              Url
+		provider         ProviderConfig
+		state            TargetState
+		everReady        bool
+		cleanupRequested bool
+		cascadeRequested bool
+		created          auth.Created
+	}
+
+	TargetCreated struct {
+		ID       TargetID
+		Name     string
+		Url      Url
+		Provider ProviderConfig
+		State    TargetState
+		Created  auth.Created
+	}
+
+	TargetRenamed struct {
+		ID   TargetID
+		Name string
+	}
+
+	TargetUrlChanged struct {
+		ID  TargetID
+		Url Url
+	}
+
+	TargetProviderChanged struct {
+		ID       TargetID
+		Provider ProviderConfig
+	}
+
+	TargetStateChanged struct {
+		ID    TargetID
+		State TargetState
+	}
+
+	// TargetCleanupRequested is raised once a target cleanup has been
+	// requested, carrying the ids of the dependent apps whose cleanup is
+	// cascaded, if any. Their AppCleanupCascaded events may be raised later,
+	// once their pending deployments have drained.
+	TargetCleanupRequested struct {
+		ID         TargetID
+		Dependents []AppID
+		Created    auth.Created
+	}
+
+	// AppCleanupCascaded is raised once per dependent app whose cleanup has
+	// been scheduled as part of a cascading target cleanup.
+	AppCleanupCascaded struct {
+		TargetID TargetID
+		AppID    AppID
+		Created  auth.Created
+	}
+
+	TargetDeleted struct {
+		ID TargetID
+	}
+)
+
+const (
+	TargetStatusConfiguring TargetStatus = iota
+	TargetStatusReady
+	TargetStatusFailed
+)
+
+const (
+	// CleanupStrategyDefault means the caller should run the actual cleanup process.
+	CleanupStrategyDefault CleanupStrategy = iota
+	// CleanupStrategySkip means the caller should skip the actual cleanup
+	// process since it has no chance of succeeding (or nothing to do).
+	CleanupStrategySkip
+)
+
+func (TargetCreated) IsEvent()          {}
+func (TargetRenamed) IsEvent()          {}
+func (TargetUrlChanged) IsEvent()       {}
+func (TargetProviderChanged) IsEvent()  {}
+func (TargetStateChanged) IsEvent()     {}
+func (TargetCleanupRequested) IsEvent() {}
+func (AppCleanupCascaded) IsEvent()     {}
+func (TargetDeleted) IsEvent()          {}
+
+// Status returns the current status of this state.
+func (s TargetState) Status() TargetStatus {
+	return s.status
+}
+
+// Err returns the error cause if the state failed to be applied.
+func (s TargetState) Err() error {
+	return s.errCause
+}
+
+// NewTarget creates a new Target, making sure its url and provider config are unique.
+func NewTarget(name string, url TargetUrlRequirement, config ProviderConfigRequirement, createdBy auth.UserID) (Target, error) {
+	if !url.unique {
+		return Target{}, ErrUrlAlreadyTaken
+	}
+
+	if !config.unique {
+		return Target{}, ErrConfigAlreadyTaken
+	}
+
+	var target Target
+
+	target.id = TargetID(id.New())
+	target.name = name
+	target.url = url.url
+	target.provider = config.config
+	target.created = auth.NewCreated(createdBy, time.Now())
+	target.state = TargetState{status: TargetStatusConfiguring, version: time.Now()}
+
+	target.Raise(TargetCreated{
+		ID:       target.id,
+		Name:     target.name,
+		Url:      target.url,
+		Provider: target.provider,
+		State:    target.state,
+		Created:  target.created,
+	})
+
+	return target, nil
+}
+
+// ID returns this target unique identifier.
+func (t *Target) ID() TargetID {
+	return t.id
+}
+
+// CurrentVersion returns the version the target should be configured at. Any
+// Configured call with an older version will be discarded since it is stale.
+func (t *Target) CurrentVersion() time.Time {
+	return t.state.version
+}
+
+// Rename changes this target name, raising an event only if the name actually changed.
+func (t *Target) Rename(name string) error {
+	if t.cleanupRequested {
+		return ErrTargetCleanupRequested
+	}
+
+	if t.name == name {
+		return nil
+	}
+
+	t.name = name
+
+	t.Raise(TargetRenamed{ID: t.id, Name: name})
+
+	return nil
+}
+
+// HasUrl updates this target url if the requirement is satisfied, triggering
+// a new configuration round if the url actually changed.
+func (t *Target) HasUrl(req TargetUrlRequirement) error {
+	if t.cleanupRequested {
+		return ErrTargetCleanupRequested
+	}
+
+	if !req.unique {
+		return ErrUrlAlreadyTaken
+	}
+
+	if t.url.String() == req.url.String() {
+		return nil
+	}
+
+	t.url = req.url
+
+	t.Raise(TargetUrlChanged{ID: t.id, Url: t.url})
+	t.signalReconfiguration()
+
+	return nil
+}
+
+// HasProvider updates this target provider config if the requirement is
+// satisfied, triggering a new configuration round if the config actually changed.
+// Switching to a provider pointing to a different infrastructure is forbidden.
+func (t *Target) HasProvider(req ProviderConfigRequirement) error {
+	if t.cleanupRequested {
+		return ErrTargetCleanupRequested
+	}
+
+	if req.config.Fingerprint() != t.provider.Fingerprint() {
+		return ErrTargetProviderUpdateNotPermitted
+	}
+
+	if !req.unique {
+		return ErrConfigAlreadyTaken
+	}
+
+	if req.config.Equals(t.provider) {
+		return nil
+	}
+
+	t.provider = req.config
+
+	t.Raise(TargetProviderChanged{ID: t.id, Provider: t.provider})
+	t.signalReconfiguration()
+
+	return nil
+}
+
+// Reconfigure triggers a new configuration round, used to retry a failed
+// configuration or force one, for example after a manual intervention.
+func (t *Target) Reconfigure() error {
+	if t.cleanupRequested {
+		return ErrTargetCleanupRequested
+	}
+
+	if t.state.status == TargetStatusConfiguring {
+		return ErrTargetConfigurationInProgress
+	}
+
+	t.signalReconfiguration()
+
+	return nil
+}
+
+// Configured applies the result of a configuration attempt, discarding it if
+// it is stale (an older version than the one currently expected).
+func (t *Target) Configured(version time.Time, err error) {
+	if version.Before(t.state.version) {
+		return
+	}
+
+	status := TargetStatusReady
+
+	if err != nil {
+		status = TargetStatusFailed
+	}
+
+	if status == t.state.status && version.Equal(t.state.version) && sameErr(err, t.state.errCause) {
+		return
+	}
+
+	t.state = TargetState{status: status, version: version, errCause: err}
+
+	if status == TargetStatusReady {
+		t.everReady = true
+	}
+
+	t.Raise(TargetStateChanged{ID: t.id, State: t.state})
+}
+
+// CheckAvailability returns an error describing why this target cannot be
+// used to receive new deployments right now, or nil if it is ready.
+func (t *Target) CheckAvailability() error {
+	switch {
+	case t.state.status == TargetStatusConfiguring:
+		return ErrTargetConfigurationInProgress
+	case t.state.status == TargetStatusFailed:
+		return ErrTargetConfigurationFailed
+	case t.cleanupRequested:
+		return ErrTargetCleanupRequested
+	default:
+		return nil
+	}
+}
+
+// CleanupStrategy tells the caller how the target resources themselves
+// should be cleaned up, given whether it still has running or pending deployments.
+func (t *Target) CleanupStrategy(hasRunningOrPendingDeployments bool) (CleanupStrategy, error) {
+	if t.state.status == TargetStatusConfiguring {
+		return CleanupStrategyDefault, ErrTargetConfigurationInProgress
+	}
+
+	if hasRunningOrPendingDeployments {
+		return CleanupStrategyDefault, ErrRunningOrPendingDeployments
+	}
+
+	if t.state.status == TargetStatusFailed {
+		if t.everReady && !t.cleanupRequested {
+			return CleanupStrategyDefault, ErrTargetConfigurationFailed
+		}
+
+		return CleanupStrategySkip, nil
+	}
+
+	return CleanupStrategyDefault, nil
+}
+
+// AppCleanupStrategy tells the caller how a single app deployed on this
+// target should be cleaned up.
+func (t *Target) AppCleanupStrategy(hasRunningOrPendingDeployments, hasSuccessfulDeployment bool) (CleanupStrategy, error) {
+	if hasRunningOrPendingDeployments {
+		return CleanupStrategyDefault, ErrRunningOrPendingDeployments
+	}
+
+	if !hasSuccessfulDeployment {
+		return CleanupStrategySkip, nil
+	}
+
+	if t.state.status == TargetStatusConfiguring {
+		return CleanupStrategyDefault, ErrTargetConfigurationInProgress
+	}
+
+	if t.cleanupRequested {
+		return CleanupStrategySkip, nil
+	}
+
+	if t.state.status == TargetStatusFailed {
+		return CleanupStrategyDefault, ErrTargetConfigurationFailed
+	}
+
+	return CleanupStrategyDefault, nil
+}
+
+// RequestCleanup marks this target for deletion. Apps still configured to
+// deploy on it block the request unless opts.CleanupDependents is set, in
+// which case their cleanup is cascaded (deferred until their pending
+// deployments have drained, if any). Calling it again once deployments have
+// drained raises the cascade that was deferred by an earlier call.
+func (t *Target) RequestCleanup(opts CleanupOptions, by auth.UserID) error {
+	if t.state.status == TargetStatusConfiguring {
+		return ErrTargetConfigurationInProgress
+	}
+
+	hasDependents := len(opts.AppsUsingTarget) > 0
+
+	if hasDependents && !opts.CleanupDependents {
+		return ErrTargetInUse
+	}
+
+	if !t.cleanupRequested {
+		t.cleanupRequested = true
+
+		t.Raise(TargetCleanupRequested{
+			ID:         t.id,
+			Dependents: opts.AppsUsingTarget,
+			Created:    auth.NewCreated(by, time.Now()),
+		})
+	}
+
+	// Raise the cascade once the dependent apps no longer have pending
+	// deployments, be it on this call or a later one re-checking the
+	// deferred cascade.
+	if hasDependents && !opts.HasPendingDeployments && !t.cascadeRequested {
+		t.cascadeRequested = true
+
+		for _, appID := range opts.AppsUsingTarget {
+			t.Raise(AppCleanupCascaded{
+				TargetID: t.id,
+				AppID:    appID,
+				Created:  auth.NewCreated(by, time.Now()),
+			})
+		}
+	}
+
+	return nil
+}
+
+// Delete removes this target once its resources have been cleaned up.
+func (t *Target) Delete(resourcesCleaned bool) error {
+	if !t.cleanupRequested || !resourcesCleaned {
+		return ErrTargetCleanupNeeded
+	}
+
+	t.Raise(TargetDeleted{ID: t.id})
+
+	return nil
+}
+
+// signalReconfiguration bumps this target version and marks it as Configuring
+// again, collapsing any previously pending state change into this new one.
+func (t *Target) signalReconfiguration() {
+	t.state = TargetState{status: TargetStatusConfiguring, version: time.Now()}
+
+	t.ReplaceOrRaise(TargetStateChanged{ID: t.id, State: t.state}, func(evt event.Event) bool {
+		_, ok := evt.(TargetStateChanged)
+		return ok
+	})
+}
+
+func sameErr(a, b error) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Error() == b.Error()
+}
@@ -65,7 +65,7 @@ func Test_Target(t *testing.T) {
 	t.Run("could not be renamed if delete requested", func(t *testing.T) {
 		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
 		target.Configured(target.CurrentVersion(), nil)
-		testutil.IsNil(t, target.RequestCleanup(false, uid))
+		testutil.IsNil(t, target.RequestCleanup(domain.CleanupOptions{}, uid))
 
 		testutil.ErrorIs(t, domain.ErrTargetCleanupRequested, target.Rename("new-name"))
 	})
@@ -96,7 +96,7 @@ func Test_Target(t *testing.T) {
 
 		newUrl := domain.NewTargetUrlRequirement(must.Panic(domain.UrlFrom("http://new-url.com")), true)
 
-		testutil.IsNil(t, target.RequestCleanup(false, uid))
+		testutil.IsNil(t, target.RequestCleanup(domain.CleanupOptions{}, uid))
 		testutil.ErrorIs(t, domain.ErrTargetCleanupRequested, target.HasUrl(newUrl))
 	})
 
@@ -136,7 +136,7 @@ func Test_Target(t *testing.T) {
 		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
 		target.Configured(target.CurrentVersion(), nil)
 
-		testutil.IsNil(t, target.RequestCleanup(false, uid))
+		testutil.IsNil(t, target.RequestCleanup(domain.CleanupOptions{}, uid))
 		testutil.ErrorIs(t, domain.ErrTargetCleanupRequested, target.HasProvider(configUnique))
 	})
 
@@ -194,7 +194,7 @@ func Test_Target(t *testing.T) {
 		testutil.IsNil(t, err)
 
 		// Delete requested
-		target.RequestCleanup(false, uid)
+		target.RequestCleanup(domain.CleanupOptions{}, uid)
 
 		err = target.CheckAvailability()
 
@@ -204,7 +204,7 @@ func Test_Target(t *testing.T) {
 	t.Run("could not be reconfigured if cleanup requested", func(t *testing.T) {
 		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
 		target.Configured(target.CurrentVersion(), nil)
-		testutil.IsNil(t, target.RequestCleanup(false, uid))
+		testutil.IsNil(t, target.RequestCleanup(domain.CleanupOptions{}, uid))
 
 		testutil.ErrorIs(t, domain.ErrTargetCleanupRequested, target.Reconfigure())
 	})
@@ -219,20 +219,93 @@ func Test_Target(t *testing.T) {
 		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
 		target.Configured(target.CurrentVersion(), nil)
 
-		testutil.ErrorIs(t, domain.ErrTargetInUse, target.RequestCleanup(true, uid))
+		testutil.ErrorIs(t, domain.ErrTargetInUse, target.RequestCleanup(domain.CleanupOptions{AppsUsingTarget: []domain.AppID{"dependent-app"}}, uid))
+	})
+
+	t.Run("could cascade cleanup to dependent apps if requested", func(t *testing.T) {
+		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
+		target.Configured(target.CurrentVersion(), nil)
+
+		err := target.RequestCleanup(domain.CleanupOptions{
+			AppsUsingTarget:   []domain.AppID{"app-1", "app-2"},
+			CleanupDependents: true,
+		}, uid)
+
+		testutil.IsNil(t, err)
+		testutil.HasNEvents(t, &target, 5)
+
+		requested := testutil.EventIs[domain.TargetCleanupRequested](t, &target, 2)
+		testutil.Equals(t, 2, len(requested.Dependents))
+
+		cascaded1 := testutil.EventIs[domain.AppCleanupCascaded](t, &target, 3)
+		testutil.Equals(t, domain.AppID("app-1"), cascaded1.AppID)
+
+		cascaded2 := testutil.EventIs[domain.AppCleanupCascaded](t, &target, 4)
+		testutil.Equals(t, domain.AppID("app-2"), cascaded2.AppID)
+	})
+
+	t.Run("should defer dependent apps cleanup while they still have pending deployments", func(t *testing.T) {
+		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
+		target.Configured(target.CurrentVersion(), nil)
+
+		err := target.RequestCleanup(domain.CleanupOptions{
+			AppsUsingTarget:       []domain.AppID{"app-1"},
+			HasPendingDeployments: true,
+			CleanupDependents:     true,
+		}, uid)
+
+		testutil.IsNil(t, err)
+		testutil.HasNEvents(t, &target, 3) // No AppCleanupCascaded raised yet
+
+		requested := testutil.EventIs[domain.TargetCleanupRequested](t, &target, 2)
+		testutil.Equals(t, 1, len(requested.Dependents))
+
+		testutil.ErrorIs(t, domain.ErrTargetCleanupRequested, target.CheckAvailability())
+	})
+
+	t.Run("should raise the deferred cascade once pending deployments have drained", func(t *testing.T) {
+		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
+		target.Configured(target.CurrentVersion(), nil)
+
+		err := target.RequestCleanup(domain.CleanupOptions{
+			AppsUsingTarget:       []domain.AppID{"app-1"},
+			HasPendingDeployments: true,
+			CleanupDependents:     true,
+		}, uid)
+
+		testutil.IsNil(t, err)
+		testutil.HasNEvents(t, &target, 3) // No AppCleanupCascaded raised yet
+
+		err = target.RequestCleanup(domain.CleanupOptions{
+			AppsUsingTarget:   []domain.AppID{"app-1"},
+			CleanupDependents: true,
+		}, uid)
+
+		testutil.IsNil(t, err)
+		testutil.HasNEvents(t, &target, 4) // A single TargetCleanupRequested, now followed by the cascade
+
+		cascaded := testutil.EventIs[domain.AppCleanupCascaded](t, &target, 3)
+		testutil.Equals(t, domain.AppID("app-1"), cascaded.AppID)
+
+		// Calling it again once the cascade has been raised should be a no-op.
+		testutil.IsNil(t, target.RequestCleanup(domain.CleanupOptions{
+			AppsUsingTarget:   []domain.AppID{"app-1"},
+			CleanupDependents: true,
+		}, uid))
+		testutil.HasNEvents(t, &target, 4)
 	})
 
 	t.Run("should not be removed if configuring", func(t *testing.T) {
 		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
 
-		testutil.ErrorIs(t, domain.ErrTargetConfigurationInProgress, target.RequestCleanup(false, uid))
+		testutil.ErrorIs(t, domain.ErrTargetConfigurationInProgress, target.RequestCleanup(domain.CleanupOptions{}, uid))
 	})
 
 	t.Run("could be removed if no app is using it", func(t *testing.T) {
 		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
 		target.Configured(target.CurrentVersion(), nil)
 
-		err := target.RequestCleanup(false, uid)
+		err := target.RequestCleanup(domain.CleanupOptions{}, uid)
 		testutil.IsNil(t, err)
 
 		testutil.IsNil(t, err)
@@ -245,8 +318,8 @@ func Test_Target(t *testing.T) {
 		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
 		target.Configured(target.CurrentVersion(), nil)
 
-		testutil.IsNil(t, target.RequestCleanup(false, uid))
-		testutil.IsNil(t, target.RequestCleanup(false, uid))
+		testutil.IsNil(t, target.RequestCleanup(domain.CleanupOptions{}, uid))
+		testutil.IsNil(t, target.RequestCleanup(domain.CleanupOptions{}, uid))
 
 		testutil.HasNEvents(t, &target, 3)
 	})
@@ -273,7 +346,7 @@ func Test_Target(t *testing.T) {
 		target.Configured(target.CurrentVersion(), nil)
 		target.Reconfigure()
 		target.Configured(target.CurrentVersion(), errors.New("configuration failed"))
-		target.RequestCleanup(false, uid)
+		target.RequestCleanup(domain.CleanupOptions{}, uid)
 
 		s, err := target.CleanupStrategy(false)
 
@@ -323,7 +396,7 @@ func Test_Target(t *testing.T) {
 	t.Run("returns a skip strategy when trying to cleanup an app on a deleting target", func(t *testing.T) {
 		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
 		target.Configured(target.CurrentVersion(), nil)
-		testutil.IsNil(t, target.RequestCleanup(false, uid))
+		testutil.IsNil(t, target.RequestCleanup(domain.CleanupOptions{}, uid))
 
 		s, err := target.AppCleanupStrategy(false, false)
 
@@ -381,7 +454,7 @@ func Test_Target(t *testing.T) {
 	t.Run("should not be removed if target resources have not been cleaned up", func(t *testing.T) {
 		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
 		target.Configured(target.CurrentVersion(), nil)
-		testutil.IsNil(t, target.RequestCleanup(false, uid)) // No application is using it
+		testutil.IsNil(t, target.RequestCleanup(domain.CleanupOptions{}, uid)) // No application is using it
 
 		err := target.Delete(false)
 
@@ -391,7 +464,7 @@ func Test_Target(t *testing.T) {
 	t.Run("could be removed if resources have been cleaned up", func(t *testing.T) {
 		target := must.Panic(domain.NewTarget(name, urlUnique, configUnique, uid))
 		target.Configured(target.CurrentVersion(), nil)
-		testutil.IsNil(t, target.RequestCleanup(false, uid))
+		testutil.IsNil(t, target.RequestCleanup(domain.CleanupOptions{}, uid))
 
 		err := target.Delete(true)
 
@@ -0,0 +1,35 @@
+package domain
+
+import "net/url"
+
+// Url represents a validated target url.
+type Url struct {
+	value string
+}
+
+// UrlFrom validates and builds a new Url from the given raw string.
+func UrlFrom(raw string) (Url, error) {
+	if _, err := url.ParseRequestURI(raw); err != nil {
+		return Url{}, err
+	}
+
+	return Url{value: raw}, nil
+}
+
+func (u Url) String() string {
+	return u.value
+}
+
+// TargetUrlRequirement carries the result of the uniqueness check a caller
+// already ran against the targets store, letting the Target aggregate stay
+// free of any storage concern.
+type TargetUrlRequirement struct {
+	url    Url
+	unique bool
+}
+
+// NewTargetUrlRequirement builds a new requirement for the given url, isUnique
+// being the result of the uniqueness check already performed by the caller.
+func NewTargetUrlRequirement(url Url, isUnique bool) TargetUrlRequirement {
+	return TargetUrlRequirement{url: url, unique: isUnique}
+}
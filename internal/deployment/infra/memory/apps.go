@@ -0,0 +1,49 @@
+// Package memory provides in-memory implementations of the deployment stores,
+// used in tests to avoid spinning up a real database.
+package memory
+
+import (
+	"context"
+
+	"github.com/YuukanOO/seelf/internal/deployment/domain"
+	"github.com/YuukanOO/seelf/pkg/apperr"
+)
+
+// AppsStore is an in-memory domain.AppsReader used for testing purposes.
+type AppsStore struct {
+	apps map[domain.AppID]*domain.App
+}
+
+// NewAppsStore builds a new AppsStore seeded with the given apps.
+func NewAppsStore(apps ...*domain.App) *AppsStore {
+	s := &AppsStore{apps: make(map[domain.AppID]*domain.App, len(apps))}
+
+	for _, app := range apps {
+		s.apps[app.ID()] = app
+	}
+
+	return s
+}
+
+func (s *AppsStore) GetByID(ctx context.Context, id domain.AppID) (domain.App, error) {
+	app, ok := s.apps[id]
+
+	if !ok {
+		return domain.App{}, apperr.ErrNotFound
+	}
+
+	return *app, nil
+}
+
+// AppsUsingTarget returns the ids of every app configured to deploy on the given target.
+func (s *AppsStore) AppsUsingTarget(ctx context.Context, target domain.TargetID) ([]domain.AppID, error) {
+	var ids []domain.AppID
+
+	for _, app := range s.apps {
+		if app.UsesTarget(target) {
+			ids = append(ids, app.ID())
+		}
+	}
+
+	return ids, nil
+}
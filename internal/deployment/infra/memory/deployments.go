@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/YuukanOO/seelf/internal/deployment/domain"
+	"github.com/YuukanOO/seelf/pkg/apperr"
+)
+
+// DeploymentsStore is an in-memory domain.DeploymentsReader/Writer used for
+// testing purposes.
+type DeploymentsStore struct {
+	deployments map[domain.AppID][]*domain.Deployment
+}
+
+// NewDeploymentsStore builds a new DeploymentsStore seeded with the given deployments.
+func NewDeploymentsStore(deployments ...*domain.Deployment) *DeploymentsStore {
+	s := &DeploymentsStore{deployments: make(map[domain.AppID][]*domain.Deployment)}
+
+	for _, dpl := range deployments {
+		appID := dpl.ID().AppID()
+		s.deployments[appID] = append(s.deployments[appID], dpl)
+	}
+
+	return s
+}
+
+func (s *DeploymentsStore) GetByID(ctx context.Context, id domain.DeploymentID) (domain.Deployment, error) {
+	for _, dpl := range s.deployments[id.AppID()] {
+		if dpl.ID() == id {
+			return *dpl, nil
+		}
+	}
+
+	return domain.Deployment{}, apperr.ErrNotFound
+}
+
+func (s *DeploymentsStore) GetNextDeploymentNumber(ctx context.Context, appID domain.AppID) (int, error) {
+	return len(s.deployments[appID]) + 1, nil
+}
+
+func (s *DeploymentsStore) GetByRequestID(ctx context.Context, appID domain.AppID, requestID string) (domain.Deployment, error) {
+	if requestID != "" {
+		for _, dpl := range s.deployments[appID] {
+			if dpl.RequestID() == requestID {
+				return *dpl, nil
+			}
+		}
+	}
+
+	return domain.Deployment{}, apperr.ErrNotFound
+}
+
+func (s *DeploymentsStore) Write(ctx context.Context, deployment *domain.Deployment) error {
+	appID := deployment.ID().AppID()
+	s.deployments[appID] = append(s.deployments[appID], deployment)
+
+	return nil
+}
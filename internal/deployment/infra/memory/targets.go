@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/YuukanOO/seelf/internal/deployment/domain"
+	"github.com/YuukanOO/seelf/pkg/apperr"
+)
+
+// TargetsStore is an in-memory domain.TargetsReader used for testing purposes.
+type TargetsStore struct {
+	targets map[domain.TargetID]*domain.Target
+}
+
+// NewTargetsStore builds a new TargetsStore seeded with the given targets.
+func NewTargetsStore(targets ...*domain.Target) *TargetsStore {
+	s := &TargetsStore{targets: make(map[domain.TargetID]*domain.Target, len(targets))}
+
+	for _, target := range targets {
+		s.targets[target.ID()] = target
+	}
+
+	return s
+}
+
+func (s *TargetsStore) GetByID(ctx context.Context, id domain.TargetID) (domain.Target, error) {
+	target, ok := s.targets[id]
+
+	if !ok {
+		return domain.Target{}, apperr.ErrNotFound
+	}
+
+	return *target, nil
+}
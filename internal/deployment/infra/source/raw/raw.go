@@ -0,0 +1,10 @@
+// Package raw implements the simplest domain.Source possible, a deployment
+// payload given as-is by the caller.
+package raw
+
+// Data is a raw deployment payload, used mostly for testing purposes.
+type Data string
+
+func (Data) Kind() string {
+	return "raw"
+}
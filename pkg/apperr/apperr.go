@@ -0,0 +1,8 @@
+// Package apperr exposes sentinel errors shared across the whole application,
+// regardless of the bounded context they are raised from.
+package apperr
+
+import "errors"
+
+// ErrNotFound is returned by stores when the requested resource could not be found.
+var ErrNotFound = errors.New("resource not found")
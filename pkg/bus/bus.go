@@ -0,0 +1,16 @@
+// Package bus provides the building blocks needed to implement a simple
+// request/handler pattern, decoupling a request from how it is processed.
+package bus
+
+import "context"
+
+// Request represents the intent to do something, expecting a result of type TResult
+// once processed by its related handler. The Request method is never meant to
+// be called, it only exists to tie a request type to its result type so the
+// compiler can check a handler processes the right kind of request.
+type Request[TResult any] interface {
+	Request() TResult
+}
+
+// RequestHandler processes a specific TRequest and returns the associated result.
+type RequestHandler[TResult any, TRequest Request[TResult]] func(ctx context.Context, req TRequest) (TResult, error)
@@ -0,0 +1,45 @@
+// Package event provides a tiny building block used by domain aggregates to
+// record what happened so it can be dispatched once persisted.
+package event
+
+// Event is the marker interface implemented by every domain event.
+type Event interface {
+	// IsEvent is a no-op method used to make sure only intended types are
+	// used as events, avoiding accidental misuse of arbitrary structs.
+	IsEvent()
+}
+
+// Container exposes the events raised by an aggregate.
+type Container interface {
+	Events() []Event
+}
+
+// Emitter is meant to be embedded in aggregates needing to raise domain events.
+type Emitter struct {
+	events []Event
+}
+
+// Raise appends the given event to the list of events raised by this aggregate.
+func (e *Emitter) Raise(evt Event) {
+	e.events = append(e.events, evt)
+}
+
+// ReplaceOrRaise drops the last raised event matching the given predicate, if
+// any, then appends the given one. This is used by derived "state" events
+// which only reflect the latest value, no matter how many other events were
+// raised after the one being superseded.
+func (e *Emitter) ReplaceOrRaise(evt Event, isSameKind func(Event) bool) {
+	for i := len(e.events) - 1; i >= 0; i-- {
+		if isSameKind(e.events[i]) {
+			e.events = append(e.events[:i], e.events[i+1:]...)
+			break
+		}
+	}
+
+	e.Raise(evt)
+}
+
+// Events returns every event raised by this aggregate so far.
+func (e *Emitter) Events() []Event {
+	return e.events
+}
@@ -0,0 +1,21 @@
+// Package id provides a single way to generate unique identifiers for
+// aggregates across the whole application.
+package id
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// New generates a new random unique identifier.
+func New() string {
+	buf := make([]byte, 16)
+
+	// Extremely unlikely to fail, and there is nothing better to do than
+	// panicking if the system's secure random source is unavailable.
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,13 @@
+// Package must provides helpers to turn a (value, error) pair into a value,
+// panicking if an error occurred. It is meant to be used in places where the
+// error is not expected to happen, such as tests or package-level initialization.
+package must
+
+// Panic returns v, panicking if err is not nil.
+func Panic[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
@@ -0,0 +1,84 @@
+// Package testutil gathers small assertion helpers used throughout the test
+// suite so test cases stay terse and consistent.
+package testutil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YuukanOO/seelf/pkg/event"
+)
+
+// Equals fails the test if got is different from want.
+func Equals[T comparable](t testing.TB, want, got T) {
+	t.Helper()
+
+	if want != got {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+// NotEquals fails the test if got is equal to want.
+func NotEquals[T comparable](t testing.TB, want, got T) {
+	t.Helper()
+
+	if want == got {
+		t.Fatalf("expected something different from %v", want)
+	}
+}
+
+// IsTrue fails the test if the given value is false.
+func IsTrue(t testing.TB, value bool) {
+	t.Helper()
+
+	if !value {
+		t.Fatal("expected true, got false")
+	}
+}
+
+// IsNil fails the test if err is not nil.
+func IsNil(t testing.TB, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+}
+
+// ErrorIs fails the test if got does not wrap (or equal) want.
+func ErrorIs(t testing.TB, want, got error) {
+	t.Helper()
+
+	if !errors.Is(got, want) {
+		t.Fatalf("expected error to be %s, got %s", want, got)
+	}
+}
+
+// HasNEvents fails the test if the given container did not raise exactly n events.
+func HasNEvents(t testing.TB, container event.Container, n int) {
+	t.Helper()
+
+	if evts := container.Events(); len(evts) != n {
+		t.Fatalf("expected %d events, got %d (%v)", n, len(evts), evts)
+	}
+}
+
+// EventIs fails the test if the event at the given index is not of type T and
+// returns it for further assertions.
+func EventIs[T event.Event](t testing.TB, container event.Container, idx int) T {
+	t.Helper()
+
+	evts := container.Events()
+
+	if idx >= len(evts) {
+		t.Fatalf("expected an event at index %d, got %d events", idx, len(evts))
+	}
+
+	evt, ok := evts[idx].(T)
+
+	if !ok {
+		t.Fatalf("expected event at index %d to be %T, got %T", idx, evt, evts[idx])
+	}
+
+	return evt
+}